@@ -0,0 +1,88 @@
+package be
+
+import (
+	"cmp"
+	"math"
+	"testing"
+)
+
+// Greater asserts that got is strictly greater than min.
+func Greater[T cmp.Ordered](tb testing.TB, got, min T) {
+	tb.Helper()
+	if !(got > min) {
+		tb.Errorf("want > %v, got %v", min, got)
+	}
+}
+
+// GreaterOrEqual asserts that got is greater than or equal to min.
+func GreaterOrEqual[T cmp.Ordered](tb testing.TB, got, min T) {
+	tb.Helper()
+	if !(got >= min) {
+		tb.Errorf("want >= %v, got %v", min, got)
+	}
+}
+
+// Less asserts that got is strictly less than max.
+func Less[T cmp.Ordered](tb testing.TB, got, max T) {
+	tb.Helper()
+	if !(got < max) {
+		tb.Errorf("want < %v, got %v", max, got)
+	}
+}
+
+// LessOrEqual asserts that got is less than or equal to max.
+func LessOrEqual[T cmp.Ordered](tb testing.TB, got, max T) {
+	tb.Helper()
+	if !(got <= max) {
+		tb.Errorf("want <= %v, got %v", max, got)
+	}
+}
+
+// Between asserts that got falls within [lo, hi], inclusive.
+func Between[T cmp.Ordered](tb testing.TB, got, lo, hi T) {
+	tb.Helper()
+	if got < lo || got > hi {
+		tb.Errorf("want between %v and %v, got %v", lo, hi, got)
+	}
+}
+
+// InDelta asserts that got is within delta of want, i.e. |got-want| <= delta.
+// It fails if want, got or delta is NaN or infinite.
+func InDelta(tb testing.TB, got, want, delta float64) {
+	tb.Helper()
+	if math.IsNaN(want) || math.IsNaN(got) || math.IsNaN(delta) {
+		tb.Errorf("want ~%v (±%v), got %v: NaN is not comparable", want, delta, got)
+		return
+	}
+	if math.IsInf(want, 0) || math.IsInf(got, 0) || math.IsInf(delta, 0) {
+		tb.Errorf("want ~%v (±%v), got %v: Inf is not comparable", want, delta, got)
+		return
+	}
+	if math.Abs(got-want) > delta {
+		tb.Errorf("want ~%v (±%v), got %v", want, delta, got)
+	}
+}
+
+// InEpsilon asserts that got is within a relative tolerance of epsilon from
+// want, i.e. |got-want|/|want| <= epsilon. As a special case, if want is 0,
+// got must be exactly 0.
+func InEpsilon(tb testing.TB, got, want, epsilon float64) {
+	tb.Helper()
+	if math.IsNaN(want) || math.IsNaN(got) || math.IsNaN(epsilon) {
+		tb.Errorf("want ~%v (±%v), got %v: NaN is not comparable", want, epsilon, got)
+		return
+	}
+	if math.IsInf(want, 0) || math.IsInf(got, 0) {
+		tb.Errorf("want ~%v (±%v), got %v: Inf is not comparable", want, epsilon, got)
+		return
+	}
+	if want == 0 {
+		if got != 0 {
+			tb.Errorf("want 0, got %v", got)
+		}
+		return
+	}
+	if math.Abs(got-want)/math.Abs(want) > epsilon {
+		tb.Errorf("want ~%v (±%v), got %v", want, epsilon, got)
+	}
+}
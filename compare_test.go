@@ -0,0 +1,210 @@
+package be_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nalgeon/be"
+)
+
+func TestGreater(t *testing.T) {
+	testCases := []struct {
+		name string
+		got  int
+		min  int
+		pass bool
+		msg  string
+	}{
+		{"greater", 10, 5, true, ""},
+		{"equal", 5, 5, false, "want > 5, got 5"},
+		{"less", 3, 5, false, "want > 5, got 3"},
+		{"negative", -1, -5, true, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tb := &mockTB{}
+			be.Greater(tb, tc.got, tc.min)
+			if tb.failed == tc.pass {
+				t.Errorf("got=%v min=%v: unexpected result", tc.got, tc.min)
+			}
+			if tb.fatal {
+				t.Error("should not be fatal")
+			}
+			if tc.msg != "" && tb.msg != tc.msg {
+				t.Errorf("expected '%s', got '%s'", tc.msg, tb.msg)
+			}
+		})
+	}
+}
+
+func TestGreaterOrEqual(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		tb := &mockTB{}
+		be.GreaterOrEqual(tb, 5, 5)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("less", func(t *testing.T) {
+		tb := &mockTB{}
+		be.GreaterOrEqual(tb, 4, 5)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		wantMsg := "want >= 5, got 4"
+		if tb.msg != wantMsg {
+			t.Errorf("expected '%s', got '%s'", wantMsg, tb.msg)
+		}
+	})
+}
+
+func TestLess(t *testing.T) {
+	t.Run("less", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Less(tb, 3, 5)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("not less", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Less(tb, 5, 5)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		wantMsg := "want < 5, got 5"
+		if tb.msg != wantMsg {
+			t.Errorf("expected '%s', got '%s'", wantMsg, tb.msg)
+		}
+	})
+}
+
+func TestLessOrEqual(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		tb := &mockTB{}
+		be.LessOrEqual(tb, 5, 5)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("greater", func(t *testing.T) {
+		tb := &mockTB{}
+		be.LessOrEqual(tb, 6, 5)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		wantMsg := "want <= 5, got 6"
+		if tb.msg != wantMsg {
+			t.Errorf("expected '%s', got '%s'", wantMsg, tb.msg)
+		}
+	})
+}
+
+func TestBetween(t *testing.T) {
+	testCases := []struct {
+		name string
+		got  int
+		lo   int
+		hi   int
+		pass bool
+	}{
+		{"inside", 5, 1, 10, true},
+		{"lower bound", 1, 1, 10, true},
+		{"upper bound", 10, 1, 10, true},
+		{"below", 0, 1, 10, false},
+		{"above", 11, 1, 10, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tb := &mockTB{}
+			be.Between(tb, tc.got, tc.lo, tc.hi)
+			if tb.failed == tc.pass {
+				t.Errorf("got=%v lo=%v hi=%v: unexpected result", tc.got, tc.lo, tc.hi)
+			}
+		})
+	}
+}
+
+func TestInDelta(t *testing.T) {
+	t.Run("within", func(t *testing.T) {
+		tb := &mockTB{}
+		be.InDelta(tb, 3.2, 3.14, 0.1)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("outside", func(t *testing.T) {
+		tb := &mockTB{}
+		be.InDelta(tb, 3.2, 3.14, 0.01)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		wantMsg := "want ~3.14 (±0.01), got 3.2"
+		if tb.msg != wantMsg {
+			t.Errorf("expected '%s', got '%s'", wantMsg, tb.msg)
+		}
+	})
+	t.Run("NaN", func(t *testing.T) {
+		tb := &mockTB{}
+		be.InDelta(tb, math.NaN(), 1, 0.1)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+	t.Run("Inf", func(t *testing.T) {
+		tb := &mockTB{}
+		be.InDelta(tb, math.Inf(1), 1, 0.1)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+	t.Run("negative values", func(t *testing.T) {
+		tb := &mockTB{}
+		be.InDelta(tb, -3.0, -3.05, 0.1)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+}
+
+func TestInEpsilon(t *testing.T) {
+	t.Run("within", func(t *testing.T) {
+		tb := &mockTB{}
+		be.InEpsilon(tb, 101.0, 100.0, 0.02)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("outside", func(t *testing.T) {
+		tb := &mockTB{}
+		be.InEpsilon(tb, 110.0, 100.0, 0.02)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+	t.Run("want zero matches zero", func(t *testing.T) {
+		tb := &mockTB{}
+		be.InEpsilon(tb, 0.0, 0.0, 0.01)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("want zero mismatch", func(t *testing.T) {
+		tb := &mockTB{}
+		be.InEpsilon(tb, 0.1, 0.0, 0.01)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		wantMsg := "want 0, got 0.1"
+		if tb.msg != wantMsg {
+			t.Errorf("expected '%s', got '%s'", wantMsg, tb.msg)
+		}
+	})
+	t.Run("NaN", func(t *testing.T) {
+		tb := &mockTB{}
+		be.InEpsilon(tb, math.NaN(), 100.0, 0.01)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+}
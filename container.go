@@ -0,0 +1,153 @@
+package be
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// valuesEqual reports whether a and b are equal, preferring an Equal(T) bool
+// method when the value provides one and falling back to reflect.DeepEqual.
+func valuesEqual(a, b any) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	av := reflect.ValueOf(a)
+	if m := av.MethodByName("Equal"); m.IsValid() {
+		mt := m.Type()
+		if mt.NumIn() == 1 && mt.NumOut() == 1 && mt.Out(0).Kind() == reflect.Bool &&
+			reflect.TypeOf(b).AssignableTo(mt.In(0)) {
+			out := m.Call([]reflect.Value{reflect.ValueOf(b)})
+			return out[0].Bool()
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// Contains asserts that container holds element: a substring of a string,
+// a member of a slice or array, or a key of a map.
+func Contains(tb testing.TB, container, element any) {
+	tb.Helper()
+	cv := reflect.ValueOf(container)
+	switch cv.Kind() {
+	case reflect.String:
+		s, ok := element.(string)
+		if !ok || !strings.Contains(cv.String(), s) {
+			tb.Errorf("want %#v to contain %#v", container, element)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < cv.Len(); i++ {
+			if valuesEqual(cv.Index(i).Interface(), element) {
+				return
+			}
+		}
+		tb.Errorf("want %#v to contain %#v", container, element)
+	case reflect.Map:
+		for _, key := range cv.MapKeys() {
+			if valuesEqual(key.Interface(), element) {
+				return
+			}
+		}
+		tb.Errorf("want %#v to contain key %#v", container, element)
+	default:
+		tb.Errorf("cannot check containment on %T", container)
+	}
+}
+
+// length returns the length of v and whether v has a meaningful length.
+func length(v any) (int, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return rv.Len(), true
+	default:
+		return 0, false
+	}
+}
+
+// Len asserts that container has exactly n elements.
+func Len(tb testing.TB, container any, n int) {
+	tb.Helper()
+	l, ok := length(container)
+	if !ok {
+		tb.Errorf("cannot take len of %T", container)
+		return
+	}
+	if l != n {
+		tb.Errorf("want len %d, got %d (%v)", n, l, container)
+	}
+}
+
+// Empty asserts that v is empty: a zero-length string, slice, array, map or
+// channel, or the zero value for any other type.
+func Empty(tb testing.TB, v any) {
+	tb.Helper()
+	if !isEmpty(v) {
+		tb.Errorf("want empty, got %#v", v)
+	}
+}
+
+// NotEmpty asserts that v is not empty.
+func NotEmpty(tb testing.TB, v any) {
+	tb.Helper()
+	if isEmpty(v) {
+		tb.Errorf("want non-empty, got %#v", v)
+	}
+}
+
+func isEmpty(v any) bool {
+	if v == nil {
+		return true
+	}
+	if l, ok := length(v); ok {
+		return l == 0
+	}
+	rv := reflect.ValueOf(v)
+	return rv.IsZero()
+}
+
+// ElementsMatch asserts that got and want contain the same elements,
+// regardless of order.
+func ElementsMatch(tb testing.TB, got, want any) {
+	tb.Helper()
+	gv := reflect.ValueOf(got)
+	wv := reflect.ValueOf(want)
+	if !isSliceOrArray(gv) || !isSliceOrArray(wv) {
+		tb.Errorf("cannot compare elements of %T and %T", got, want)
+		return
+	}
+
+	missing := []any{}
+	extra := make([]any, gv.Len())
+	for i := 0; i < gv.Len(); i++ {
+		extra[i] = gv.Index(i).Interface()
+	}
+
+	for i := 0; i < wv.Len(); i++ {
+		w := wv.Index(i).Interface()
+		found := -1
+		for i, e := range extra {
+			if valuesEqual(e, w) {
+				found = i
+				break
+			}
+		}
+		if found == -1 {
+			missing = append(missing, w)
+			continue
+		}
+		extra = append(extra[:found], extra[found+1:]...)
+	}
+
+	if len(missing) == 0 && len(extra) == 0 {
+		return
+	}
+	tb.Errorf("elements do not match: missing %s, extra %s", fmt.Sprint(missing), fmt.Sprint(extra))
+}
+
+// isSliceOrArray reports whether v is a valid slice or array, treating an
+// untyped nil (the zero Value) as neither.
+func isSliceOrArray(v reflect.Value) bool {
+	return v.IsValid() && (v.Kind() == reflect.Slice || v.Kind() == reflect.Array)
+}
@@ -0,0 +1,197 @@
+package be_test
+
+import (
+	"testing"
+
+	"github.com/nalgeon/be"
+)
+
+func TestContains(t *testing.T) {
+	t.Run("string contains substring", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Contains(tb, "hello world", "world")
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("string missing substring", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Contains(tb, "hello world", "bye")
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+	t.Run("slice contains element", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Contains(tb, []int{1, 2, 3}, 2)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("slice missing element", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Contains(tb, []int{1, 2, 3}, 4)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+	t.Run("slice of Equal-method values", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Contains(tb, []noisy{newNoisy(1), newNoisy(2)}, newNoisy(2))
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("map contains key", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Contains(tb, map[string]int{"a": 1}, "a")
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("map missing key", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Contains(tb, map[string]int{"a": 1}, "b")
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+}
+
+func TestLen(t *testing.T) {
+	t.Run("matching", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Len(tb, []int{1, 2, 3}, 3)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("mismatch", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Len(tb, []string{"a", "b", "c", "d", "e"}, 3)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		wantMsg := "want len 3, got 5 ([a b c d e])"
+		if tb.msg != wantMsg {
+			t.Errorf("expected '%s', got '%s'", wantMsg, tb.msg)
+		}
+	})
+	t.Run("map", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Len(tb, map[string]int{"a": 1, "b": 2}, 2)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("unsupported type", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Len(tb, 42, 1)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+}
+
+func TestEmpty(t *testing.T) {
+	t.Run("nil slice", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Empty(tb, []int(nil))
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("empty slice", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Empty(tb, []int{})
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("non-empty slice", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Empty(tb, []int{1})
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+	t.Run("zero value struct", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Empty(tb, intType{})
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+}
+
+func TestNotEmpty(t *testing.T) {
+	t.Run("non-empty map", func(t *testing.T) {
+		tb := &mockTB{}
+		be.NotEmpty(tb, map[string]int{"a": 1})
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("empty string", func(t *testing.T) {
+		tb := &mockTB{}
+		be.NotEmpty(tb, "")
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+}
+
+func TestElementsMatch(t *testing.T) {
+	t.Run("same order", func(t *testing.T) {
+		tb := &mockTB{}
+		be.ElementsMatch(tb, []int{1, 2, 3}, []int{1, 2, 3})
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("different order", func(t *testing.T) {
+		tb := &mockTB{}
+		be.ElementsMatch(tb, []int{3, 1, 2}, []int{1, 2, 3})
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("missing element", func(t *testing.T) {
+		tb := &mockTB{}
+		be.ElementsMatch(tb, []int{1, 2}, []int{1, 2, 3})
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+	t.Run("extra element", func(t *testing.T) {
+		tb := &mockTB{}
+		be.ElementsMatch(tb, []int{1, 2, 3}, []int{1, 2})
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+	t.Run("duplicates", func(t *testing.T) {
+		tb := &mockTB{}
+		be.ElementsMatch(tb, []int{1, 1, 2}, []int{1, 2, 2})
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+	t.Run("unsupported type reports cleanly", func(t *testing.T) {
+		tb := &mockTB{}
+		be.ElementsMatch(tb, 42, []int{1, 2})
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		if tb.fatal {
+			t.Error("should not be fatal")
+		}
+	})
+	t.Run("untyped nil reports cleanly", func(t *testing.T) {
+		tb := &mockTB{}
+		be.ElementsMatch(tb, nil, []int{1, 2})
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+}
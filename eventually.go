@@ -0,0 +1,131 @@
+package be
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// deadlined is implemented by *testing.T (and anything else that wants to
+// participate in deadline-shortening); testing.TB itself does not expose
+// Deadline.
+type deadlined interface {
+	Deadline() (time.Time, bool)
+}
+
+// deadline returns the effective timeout for tb, shortened to tb.Deadline()
+// if the test framework set one and it falls before timeout.
+func deadline(tb testing.TB, timeout time.Duration) time.Duration {
+	d, ok := tb.(deadlined)
+	if !ok {
+		return timeout
+	}
+	dl, ok := d.Deadline()
+	if !ok {
+		return timeout
+	}
+	if remaining := time.Until(dl); remaining < timeout {
+		return remaining
+	}
+	return timeout
+}
+
+// checkCond runs cond in a goroutine and recovers a panic, reporting it
+// through panicked instead of letting it crash the test binary.
+func checkCond(cond func() bool) (result bool, panicked any) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if r := recover(); r != nil {
+				panicked = r
+			}
+		}()
+		result = cond()
+	}()
+	<-done
+	return result, panicked
+}
+
+// Eventually asserts that cond returns true at least once before timeout
+// elapses, polling every interval.
+func Eventually(tb testing.TB, cond func() bool, timeout, interval time.Duration) {
+	tb.Helper()
+	timeout = deadline(tb, timeout)
+
+	checks := 0
+	deadline := time.Now().Add(timeout)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		checks++
+		ok, panicked := checkCond(cond)
+		if panicked != nil {
+			tb.Fatalf("condition panicked: %v", panicked)
+			return
+		}
+		if ok {
+			return
+		}
+		if time.Now().After(deadline) {
+			tb.Errorf("condition not met within %s (checked %d times)", timeout, checks)
+			return
+		}
+		<-ticker.C
+	}
+}
+
+// Never asserts that cond does not return true within timeout, polling
+// every interval.
+func Never(tb testing.TB, cond func() bool, timeout, interval time.Duration) {
+	tb.Helper()
+	timeout = deadline(tb, timeout)
+
+	deadline := time.Now().Add(timeout)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		ok, panicked := checkCond(cond)
+		if panicked != nil {
+			tb.Fatalf("condition panicked: %v", panicked)
+			return
+		}
+		if ok {
+			tb.Errorf("want condition to never hold, but it did within %s", timeout)
+			return
+		}
+		if time.Now().After(deadline) {
+			return
+		}
+		<-ticker.C
+	}
+}
+
+// EventuallyEqual asserts that got(), polled every interval, converges to
+// want within timeout, using the same equality rules as Equal.
+func EventuallyEqual[T any](tb testing.TB, got func() T, want T, timeout, interval time.Duration) {
+	tb.Helper()
+	var last T
+	cond := func() bool {
+		last = got()
+		return valuesEqual(last, want)
+	}
+	wrapped := &lastValueTB{TB: tb, format: func() string {
+		return fmt.Sprintf("want %#v, got %#v", want, last)
+	}}
+	Eventually(wrapped, cond, timeout, interval)
+}
+
+// lastValueTB augments a failing "condition not met" message with the most
+// recently observed value, so EventuallyEqual failures look like Equal's.
+type lastValueTB struct {
+	testing.TB
+	format func() string
+}
+
+func (tb *lastValueTB) Errorf(format string, args ...any) {
+	tb.TB.Helper()
+	tb.TB.Errorf("%s (%s)", fmt.Sprintf(format, args...), tb.format())
+}
@@ -0,0 +1,109 @@
+package be_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nalgeon/be"
+)
+
+// deadlineTB adds a Deadline() override on top of mockTB, so tests can
+// exercise Eventually's deadline-shortening path.
+type deadlineTB struct {
+	*mockTB
+	dl time.Time
+}
+
+func (tb *deadlineTB) Deadline() (time.Time, bool) {
+	return tb.dl, true
+}
+
+func TestEventually(t *testing.T) {
+	t.Run("succeeds before timeout", func(t *testing.T) {
+		tb := &mockTB{}
+		var n atomic.Int32
+		cond := func() bool { return n.Add(1) >= 3 }
+		be.Eventually(tb, cond, time.Second, time.Millisecond)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("times out", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Eventually(tb, func() bool { return false }, 20*time.Millisecond, 5*time.Millisecond)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		if tb.fatal {
+			t.Error("should not be fatal")
+		}
+	})
+	t.Run("panic in cond is reported, not crashed", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Eventually(tb, func() bool { panic("boom") }, 20*time.Millisecond, 5*time.Millisecond)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+	})
+	t.Run("deadline shortens timeout", func(t *testing.T) {
+		tb := &deadlineTB{mockTB: &mockTB{}, dl: time.Now().Add(10 * time.Millisecond)}
+		start := time.Now()
+		be.Eventually(tb, func() bool { return false }, time.Hour, 2*time.Millisecond)
+		if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+			t.Errorf("did not respect shortened deadline, took %s", elapsed)
+		}
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+}
+
+func TestNever(t *testing.T) {
+	t.Run("condition never holds", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Never(tb, func() bool { return false }, 20*time.Millisecond, 5*time.Millisecond)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("condition holds too early", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Never(tb, func() bool { return true }, 20*time.Millisecond, 5*time.Millisecond)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+	t.Run("panic in cond is reported, not crashed", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Never(tb, func() bool { panic("boom") }, 20*time.Millisecond, 5*time.Millisecond)
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+	})
+}
+
+func TestEventuallyEqual(t *testing.T) {
+	t.Run("converges", func(t *testing.T) {
+		tb := &mockTB{}
+		var n atomic.Int32
+		got := func() int {
+			return int(n.Add(1))
+		}
+		be.EventuallyEqual(tb, got, 3, time.Second, time.Millisecond)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("never converges", func(t *testing.T) {
+		tb := &mockTB{}
+		got := func() int { return 1 }
+		be.EventuallyEqual(tb, got, 42, 20*time.Millisecond, 5*time.Millisecond)
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package be
+
+import (
+	"bytes"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update *bool
+
+func init() {
+	update = flag.Bool("update", false, "update golden files")
+}
+
+// goldenRoot is the directory golden paths are resolved against.
+// It defaults to "testdata" and can be overridden with GoldenDir.
+var goldenRoot = "testdata"
+
+// GoldenDir sets the root directory golden paths are resolved against.
+// It is meant for tests that exercise the golden-file machinery itself,
+// pointing it at a temporary directory instead of testdata.
+func GoldenDir(path string) {
+	goldenRoot = path
+}
+
+// Golden asserts that got matches the contents of the golden file at path
+// (resolved relative to the golden root, "testdata" by default). When the
+// test binary is run with -update, the golden file is (re)written with got
+// instead of being compared against.
+func Golden(tb testing.TB, got []byte, path string) {
+	tb.Helper()
+	full := filepath.Join(goldenRoot, path)
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			tb.Fatalf("golden: create dir: %v", err)
+		}
+		if err := os.WriteFile(full, got, 0o644); err != nil {
+			tb.Fatalf("golden: write file: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(full)
+	if err != nil {
+		tb.Fatalf("golden: read %s: %v (run with -update to create it)", full, err)
+		return
+	}
+
+	if bytes.Equal(normalizeEOL(got), normalizeEOL(want)) {
+		return
+	}
+	tb.Errorf("golden mismatch (%s):\n%s", full, diffGolden(want, got))
+}
+
+// GoldenString is the string variant of Golden.
+func GoldenString(tb testing.TB, got string, path string) {
+	tb.Helper()
+	Golden(tb, []byte(got), path)
+}
+
+func normalizeEOL(b []byte) []byte {
+	return bytes.ReplaceAll(b, []byte("\r\n"), []byte("\n"))
+}
+
+// diffGolden renders a unified-diff-style comparison between want and got.
+// Binary content (containing a NUL byte) falls back to a hex dump diff.
+func diffGolden(want, got []byte) string {
+	if bytes.IndexByte(want, 0) >= 0 || bytes.IndexByte(got, 0) >= 0 {
+		return hexDiff(want, got)
+	}
+	return lineDiff(string(normalizeEOL(want)), string(normalizeEOL(got)))
+}
+
+const diffContext = 2
+
+func lineDiff(want, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+
+	var b strings.Builder
+	n := max(len(wantLines), len(gotLines))
+	start := -1
+	for i := 0; i < n; i++ {
+		var w, g string
+		var hasW, hasG bool
+		if i < len(wantLines) {
+			w, hasW = wantLines[i], true
+		}
+		if i < len(gotLines) {
+			g, hasG = gotLines[i], true
+		}
+		if hasW && hasG && w == g {
+			continue
+		}
+		if start == -1 {
+			start = max(0, i-diffContext)
+			for j := start; j < i; j++ {
+				fmt.Fprintf(&b, "  %s\n", wantLines[j])
+			}
+		}
+		if hasW {
+			fmt.Fprintf(&b, "- %s\n", w)
+		}
+		if hasG {
+			fmt.Fprintf(&b, "+ %s\n", g)
+		}
+	}
+	return b.String()
+}
+
+func hexDiff(want, got []byte) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "- %s\n", hex.Dump(want))
+	fmt.Fprintf(&b, "+ %s\n", hex.Dump(got))
+	return b.String()
+}
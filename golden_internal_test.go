@@ -0,0 +1,76 @@
+package be
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// mockTB mirrors the be_test package's mock, kept minimal since this file
+// only needs to exercise the -update branch from inside the package.
+type mockTB struct {
+	testing.TB
+	failed bool
+	fatal  bool
+	msg    string
+}
+
+func (m *mockTB) Helper() {}
+
+func (m *mockTB) Fatal(args ...any) {
+	m.fatal = true
+	m.Error(args...)
+}
+
+func (m *mockTB) Fatalf(format string, args ...any) {
+	m.fatal = true
+	m.Errorf(format, args...)
+}
+
+func (m *mockTB) Error(args ...any) {
+	m.failed = true
+	m.msg = fmt.Sprint(args...)
+}
+
+func (m *mockTB) Errorf(format string, args ...any) {
+	m.failed = true
+	m.msg = fmt.Sprintf(format, args...)
+}
+
+func TestGoldenUpdate(t *testing.T) {
+	dir := t.TempDir()
+	GoldenDir(dir)
+	defer GoldenDir("testdata")
+
+	*update = true
+	defer func() { *update = false }()
+
+	tb := &mockTB{}
+	Golden(tb, []byte("fresh content"), "nested/out.golden")
+	if tb.failed {
+		t.Fatalf("update should not fail: %s", tb.msg)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dir, "nested", "out.golden"))
+	if err != nil {
+		t.Fatalf("golden file was not written: %v", err)
+	}
+	if string(got) != "fresh content" {
+		t.Fatalf("want %q, got %q", "fresh content", got)
+	}
+
+	// With the flag still set, a second Golden call rewrites rather than
+	// compares, so changed content is accepted without failing.
+	Golden(tb, []byte("changed content"), "nested/out.golden")
+	if tb.failed {
+		t.Fatalf("update should not fail: %s", tb.msg)
+	}
+
+	*update = false
+	tb2 := &mockTB{}
+	Golden(tb2, []byte("changed content"), "nested/out.golden")
+	if tb2.failed {
+		t.Fatalf("compare after update should pass: %s", tb2.msg)
+	}
+}
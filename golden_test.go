@@ -0,0 +1,108 @@
+package be_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nalgeon/be"
+)
+
+func TestGolden(t *testing.T) {
+	t.Run("matches", func(t *testing.T) {
+		dir := t.TempDir()
+		be.GoldenDir(dir)
+		defer be.GoldenDir("testdata")
+
+		if err := os.WriteFile(filepath.Join(dir, "out.golden"), []byte("hello\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		tb := &mockTB{}
+		be.Golden(tb, []byte("hello\n"), "out.golden")
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("normalizes line endings", func(t *testing.T) {
+		dir := t.TempDir()
+		be.GoldenDir(dir)
+		defer be.GoldenDir("testdata")
+
+		if err := os.WriteFile(filepath.Join(dir, "out.golden"), []byte("hello\r\nworld\r\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		tb := &mockTB{}
+		be.Golden(tb, []byte("hello\nworld\n"), "out.golden")
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		dir := t.TempDir()
+		be.GoldenDir(dir)
+		defer be.GoldenDir("testdata")
+
+		if err := os.WriteFile(filepath.Join(dir, "out.golden"), []byte("hello\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		tb := &mockTB{}
+		be.Golden(tb, []byte("goodbye\n"), "out.golden")
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		if tb.fatal {
+			t.Error("should not be fatal")
+		}
+	})
+
+	t.Run("binary mismatch falls back to hex dump", func(t *testing.T) {
+		dir := t.TempDir()
+		be.GoldenDir(dir)
+		defer be.GoldenDir("testdata")
+
+		if err := os.WriteFile(filepath.Join(dir, "out.golden"), []byte{0x00, 0x01, 0x02}, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		tb := &mockTB{}
+		be.Golden(tb, []byte{0x00, 0x01, 0x03}, "out.golden")
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		if !strings.Contains(tb.msg, "+ 00000000  00 01 03") {
+			t.Errorf("expected hex dump diff, got '%s'", tb.msg)
+		}
+	})
+
+	t.Run("missing golden file is a fatal setup error", func(t *testing.T) {
+		dir := t.TempDir()
+		be.GoldenDir(dir)
+		defer be.GoldenDir("testdata")
+
+		tb := &mockTB{}
+		be.Golden(tb, []byte("missing"), "nested/out.golden")
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+	})
+
+	t.Run("string variant", func(t *testing.T) {
+		dir := t.TempDir()
+		be.GoldenDir(dir)
+		defer be.GoldenDir("testdata")
+
+		if err := os.WriteFile(filepath.Join(dir, "out.golden"), []byte("hello"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		tb := &mockTB{}
+		be.GoldenString(tb, "hello", "out.golden")
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+}
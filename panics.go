@@ -0,0 +1,96 @@
+package be
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"testing"
+)
+
+// Panics asserts that fn panics. If wants are given, the recovered value
+// must match at least one of them: an error want is matched with
+// errors.Is, a string want is matched as a substring of fmt.Sprint(recovered),
+// a reflect.Type want is matched against the recovered value's type
+// (dereferencing pointers), and any other want is compared with the same
+// equality rules as Equal.
+func Panics(tb testing.TB, fn func(), wants ...any) {
+	tb.Helper()
+	recovered, didPanic := invoke(fn)
+	if !didPanic {
+		tb.Errorf("want panic, got none")
+		return
+	}
+	if len(wants) == 0 {
+		return
+	}
+	for _, want := range wants {
+		if panicMatches(recovered, want) {
+			return
+		}
+	}
+	tb.Errorf("want any of the %v, got panic(%v)", wants, recovered)
+}
+
+// NotPanics asserts that fn does not panic. It fails fatally, including the
+// stack at the point of the panic, since a panicking fn leaves the caller in
+// an unknown state.
+func NotPanics(tb testing.TB, fn func()) {
+	tb.Helper()
+	recovered, didPanic := invokeWithStack(fn)
+	if didPanic {
+		tb.Fatalf("unexpected panic: %v\n%s", recovered.value, recovered.stack)
+	}
+}
+
+// invoke runs fn, recovering a panic if one occurs.
+func invoke(fn func()) (recovered any, didPanic bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			recovered, didPanic = r, true
+		}
+	}()
+	fn()
+	return nil, false
+}
+
+type panicInfo struct {
+	value any
+	stack []byte
+}
+
+// invokeWithStack runs fn, capturing the stack at the point of a panic.
+func invokeWithStack(fn func()) (info panicInfo, didPanic bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			info, didPanic = panicInfo{value: r, stack: debug.Stack()}, true
+		}
+	}()
+	fn()
+	return panicInfo{}, false
+}
+
+func panicMatches(recovered, want any) bool {
+	switch w := want.(type) {
+	case error:
+		if err, ok := recovered.(error); ok {
+			return errors.Is(err, w)
+		}
+		return false
+	case string:
+		return strings.Contains(fmt.Sprint(recovered), w)
+	case reflect.Type:
+		t := reflect.TypeOf(recovered)
+		for t != nil && t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		wt := w
+		for wt != nil && wt.Kind() == reflect.Ptr {
+			wt = wt.Elem()
+		}
+		return t == wt
+	default:
+		return valuesEqual(recovered, want)
+	}
+}
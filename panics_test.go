@@ -0,0 +1,150 @@
+package be_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/nalgeon/be"
+)
+
+func TestPanics(t *testing.T) {
+	t.Run("no wants", func(t *testing.T) {
+		t.Run("panics", func(t *testing.T) {
+			tb := &mockTB{}
+			be.Panics(tb, func() { panic("boom") })
+			if tb.failed {
+				t.Errorf("failed: %s", tb.msg)
+			}
+		})
+		t.Run("does not panic", func(t *testing.T) {
+			tb := &mockTB{}
+			be.Panics(tb, func() {})
+			if !tb.failed {
+				t.Error("should have failed")
+			}
+		})
+		t.Run("nil panic", func(t *testing.T) {
+			// Go's runtime turns panic(nil) into a *runtime.PanicNilError,
+			// so this should still be reported as a panic.
+			tb := &mockTB{}
+			be.Panics(tb, func() { panic(nil) })
+			if tb.failed {
+				t.Errorf("failed: %s", tb.msg)
+			}
+		})
+	})
+	t.Run("error want", func(t *testing.T) {
+		sentinel := errors.New("sentinel")
+		t.Run("matches via errors.Is", func(t *testing.T) {
+			tb := &mockTB{}
+			be.Panics(tb, func() { panic(sentinel) }, sentinel)
+			if tb.failed {
+				t.Errorf("failed: %s", tb.msg)
+			}
+		})
+		t.Run("does not match", func(t *testing.T) {
+			tb := &mockTB{}
+			be.Panics(tb, func() { panic(errors.New("other")) }, sentinel)
+			if !tb.failed {
+				t.Error("should have failed")
+			}
+		})
+	})
+	t.Run("string want", func(t *testing.T) {
+		t.Run("substring matches", func(t *testing.T) {
+			tb := &mockTB{}
+			be.Panics(tb, func() { panic("index out of range") }, "out of range")
+			if tb.failed {
+				t.Errorf("failed: %s", tb.msg)
+			}
+		})
+		t.Run("substring does not match", func(t *testing.T) {
+			tb := &mockTB{}
+			be.Panics(tb, func() { panic("boom") }, "fizz")
+			if !tb.failed {
+				t.Error("should have failed")
+			}
+		})
+	})
+	t.Run("type want", func(t *testing.T) {
+		t.Run("matches", func(t *testing.T) {
+			tb := &mockTB{}
+			be.Panics(tb, func() { panic(errType("oops")) }, reflect.TypeFor[errType]())
+			if tb.failed {
+				t.Errorf("failed: %s", tb.msg)
+			}
+		})
+		t.Run("does not match", func(t *testing.T) {
+			tb := &mockTB{}
+			be.Panics(tb, func() { panic(errType("oops")) }, reflect.TypeFor[int]())
+			if !tb.failed {
+				t.Error("should have failed")
+			}
+		})
+	})
+	t.Run("value want", func(t *testing.T) {
+		t.Run("matches", func(t *testing.T) {
+			tb := &mockTB{}
+			be.Panics(tb, func() { panic(42) }, 42)
+			if tb.failed {
+				t.Errorf("failed: %s", tb.msg)
+			}
+		})
+	})
+	t.Run("multiple wants, one matches", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Panics(tb, func() { panic(42) }, 1, 2, 42)
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("custom type", func(t *testing.T) {
+		tb := &mockTB{}
+		be.Panics(tb, func() { panic(intType{42}) }, intType{42})
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+}
+
+func TestNotPanics(t *testing.T) {
+	t.Run("does not panic", func(t *testing.T) {
+		tb := &mockTB{}
+		be.NotPanics(tb, func() {})
+		if tb.failed {
+			t.Errorf("failed: %s", tb.msg)
+		}
+	})
+	t.Run("panics", func(t *testing.T) {
+		tb := &mockTB{}
+		be.NotPanics(tb, func() { panic("boom") })
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+		if !tb.fatal {
+			t.Error("should be fatal")
+		}
+	})
+	t.Run("runtime error panic", func(t *testing.T) {
+		tb := &mockTB{}
+		be.NotPanics(tb, func() {
+			var s []int
+			_ = s[0]
+		})
+		if !tb.failed {
+			t.Error("should have failed")
+		}
+	})
+	t.Run("goroutine continues after recovery", func(t *testing.T) {
+		// A second call after a recovered panic must behave normally,
+		// proving the panic was not silently swallowed beyond this call.
+		tb := &mockTB{}
+		be.NotPanics(tb, func() { panic("first") })
+		tb2 := &mockTB{}
+		be.NotPanics(tb2, func() {})
+		if tb2.failed {
+			t.Errorf("failed: %s", tb2.msg)
+		}
+	})
+}